@@ -0,0 +1,146 @@
+package tableprinter
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// CSVOptions customizes how `CSVParser` (and `Printer#PrintCSV`) read a CSV/TSV document.
+type CSVOptions struct {
+	// Delimiter is the field separator, defaults to ',' when zero. Set it to '\t' for TSV.
+	Delimiter rune
+	// Comment, when set, makes encoding/csv treat lines starting with that rune as comments.
+	Comment rune
+	// NoHeader treats the first record as data instead of headers, synthesizing "Col1".."ColN" instead.
+	NoHeader bool
+}
+
+// csvParser reads a CSV/TSV document, mirroring `JSONParser`'s role for JSON input: the first
+// record becomes the header row (unless `CSVOptions.NoHeader` is set) and every following
+// record becomes a row. Numeric columns are auto-detected with the same decimal/percent
+// heuristic tablewriter itself uses to right-align numbers, so `Render` does the same for
+// CSV/TSV input as it already does for structs and JSON.
+type csvParser struct{}
+
+// CSVParser is the package's `csvParser`, see `Printer#CSVOptions` to customize the delimiter,
+// comment rune or header handling per `Printer`, and `PrintCSV`/`Printer#PrintCSV` to use it.
+var CSVParser csvParser
+
+func (csvParser) parse(r io.Reader, opts CSVOptions) (headers []string, rows [][]string, numericColumns []int) {
+	cr := csv.NewReader(r)
+	if opts.Delimiter != 0 {
+		cr.Comma = opts.Delimiter
+	}
+	if opts.Comment != 0 {
+		cr.Comment = opts.Comment
+	}
+	cr.FieldsPerRecord = -1
+
+	records, err := cr.ReadAll()
+	if err != nil || len(records) == 0 {
+		return nil, nil, nil
+	}
+
+	if opts.NoHeader {
+		headers = make([]string, len(records[0]))
+		for i := range headers {
+			headers[i] = fmt.Sprintf("Col%d", i+1)
+		}
+		rows = records
+	} else {
+		headers = records[0]
+		rows = records[1:]
+	}
+
+	return headers, rows, numericColumnsOf(headers, rows)
+}
+
+// numericColumnsOf returns the indices of the columns whose cells all parse as a number
+// (optionally followed by "%"), the same heuristic tablewriter uses for auto-alignment.
+func numericColumnsOf(headers []string, rows [][]string) []int {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	var numericColumns []int
+	for col := range headers {
+		numeric := true
+		for _, row := range rows {
+			if col >= len(row) || !isNumericCell(row[col]) {
+				numeric = false
+				break
+			}
+		}
+
+		if numeric {
+			numericColumns = append(numericColumns, col)
+		}
+	}
+
+	return numericColumns
+}
+
+func isNumericCell(value string) bool {
+	value = strings.TrimSuffix(strings.TrimSpace(value), "%")
+	if value == "" {
+		return false
+	}
+
+	_, err := strconv.ParseFloat(value, 64)
+	return err == nil
+}
+
+// PrintCSV reads a CSV/TSV document from "r" and outputs it as a table to "w", filters can be
+// used to control what rows can be visible or hidden, see `Printer#CSVOptions` to customize the
+// delimiter, comment rune or header handling.
+//
+// Returns the total amount of rows written to the table.
+func PrintCSV(w io.Writer, r io.Reader, filters ...interface{}) int {
+	return New(w).PrintCSV(r, filters...)
+}
+
+// PrintCSV reads a CSV/TSV document from "r" and outputs it as a table, filters can be used to
+// control what rows can be visible or hidden, see `Printer#CSVOptions` to customize the
+// delimiter, comment rune or header handling.
+//
+// Returns the total amount of rows written to the table.
+func (p *Printer) PrintCSV(r io.Reader, filters ...interface{}) int {
+	headers, rows, nums := CSVParser.parse(r, p.CSVOptions)
+
+	if len(filters) > 0 {
+		rows = filterCSVRows(headers, rows, filters)
+		// a filter can remove the very rows that made (or broke) a column's "all numeric"
+		// property, so numeric columns must be re-detected against the surviving rows.
+		nums = numericColumnsOf(headers, rows)
+	}
+
+	return p.Render(headers, rows, nums, true)
+}
+
+// filterCSVRows applies "filters" (one `func(map[string]string) bool` per call, matching the
+// semantics of `Print`'s filters) to each CSV "row", keyed by "headers".
+func filterCSVRows(headers []string, rows [][]string, filters []interface{}) [][]string {
+	if len(filters) == 0 {
+		return rows
+	}
+
+	kept := make([][]string, 0, len(rows))
+	for _, row := range rows {
+		record := make(map[string]string, len(headers))
+		for i, header := range headers {
+			if i < len(row) {
+				record[header] = row[i]
+			}
+		}
+
+		if keepItem(reflect.ValueOf(record), filters) {
+			kept = append(kept, row)
+		}
+	}
+
+	return kept
+}
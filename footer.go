@@ -0,0 +1,184 @@
+package tableprinter
+
+import "strconv"
+
+// Reducer computes a single footer cell out of all the string values collected for its column.
+//
+// See `Printer#SetColumnReducer` and the builtin `SumReducer`, `AvgReducer`, `MinReducer`,
+// `MaxReducer` and `CountReducer` too.
+//
+// Reducers can only be registered programmatically through `SetColumnReducer` for now, there is
+// no struct-tag equivalent (e.g. `header:"Cost,footer=sum"`) yet: that would need to be wired
+// into this package's struct tag parser, which is not part of this slice of the repository.
+type Reducer func(values []string) string
+
+// SumReducer returns the sum of the column's numeric values, non-numeric values are ignored.
+var SumReducer Reducer = func(values []string) string {
+	sum := reduceFloats(values, func(acc, v float64) float64 { return acc + v })
+	return strconv.FormatFloat(sum, 'f', -1, 64)
+}
+
+// AvgReducer returns the average of the column's numeric values, non-numeric values are ignored.
+var AvgReducer Reducer = func(values []string) string {
+	n := 0
+	sum := reduceFloatsFunc(values, func(v float64) { n++ }, func(acc, v float64) float64 { return acc + v })
+	if n == 0 {
+		return "0"
+	}
+	return strconv.FormatFloat(sum/float64(n), 'f', -1, 64)
+}
+
+// MinReducer returns the smallest of the column's numeric values, non-numeric values are ignored.
+var MinReducer Reducer = func(values []string) string {
+	first := true
+	min := 0.0
+	for _, value := range values {
+		f, ok := parseFloat(value)
+		if !ok {
+			continue
+		}
+
+		if first || f < min {
+			min = f
+			first = false
+		}
+	}
+
+	return strconv.FormatFloat(min, 'f', -1, 64)
+}
+
+// MaxReducer returns the largest of the column's numeric values, non-numeric values are ignored.
+var MaxReducer Reducer = func(values []string) string {
+	first := true
+	max := 0.0
+	for _, value := range values {
+		f, ok := parseFloat(value)
+		if !ok {
+			continue
+		}
+
+		if first || f > max {
+			max = f
+			first = false
+		}
+	}
+
+	return strconv.FormatFloat(max, 'f', -1, 64)
+}
+
+// CountReducer returns the amount of rows collected for its column, including non-numeric ones.
+var CountReducer Reducer = func(values []string) string {
+	return strconv.Itoa(len(values))
+}
+
+func parseFloat(value string) (float64, bool) {
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return f, true
+}
+
+func reduceFloats(values []string, reduce func(acc, v float64) float64) float64 {
+	return reduceFloatsFunc(values, nil, reduce)
+}
+
+func reduceFloatsFunc(values []string, onValue func(v float64), reduce func(acc, v float64) float64) float64 {
+	acc := 0.0
+	for _, value := range values {
+		f, ok := parseFloat(value)
+		if !ok {
+			continue
+		}
+
+		if onValue != nil {
+			onValue(f)
+		}
+		acc = reduce(acc, f)
+	}
+
+	return acc
+}
+
+// SetColumnReducer registers a `Reducer` for the given zero-based column index, its outcome
+// is used to fill that column's footer cell once the table is rendered (or flushed, for the
+// live-update use case of `RenderRow`). It overrides any manually set `Printer#Footers` value
+// for the same column.
+func (p *Printer) SetColumnReducer(col int, reducer Reducer) {
+	if p.columnReducers == nil {
+		p.columnReducers = make(map[int]Reducer)
+	}
+
+	p.columnReducers[col] = reducer
+}
+
+func (p *Printer) collectColumnValues(rows [][]string) {
+	if len(p.columnReducers) == 0 {
+		return
+	}
+
+	if p.columnValues == nil {
+		p.columnValues = make(map[int][]string)
+	}
+
+	for _, row := range rows {
+		if len(row) > p.columnCount {
+			p.columnCount = len(row)
+		}
+
+		for col, cell := range row {
+			if _, ok := p.columnReducers[col]; !ok {
+				continue
+			}
+
+			p.columnValues[col] = append(p.columnValues[col], cell)
+		}
+	}
+}
+
+// computeFooters builds the footer row out of the registered column reducers, falling back to
+// `Footers` for columns without a reducer. It returns nil when no footer should be rendered at all.
+func (p *Printer) computeFooters(size int) []string {
+	if len(p.columnReducers) == 0 && len(p.Footers) == 0 {
+		return nil
+	}
+
+	if size <= 0 {
+		size = p.columnCount
+		if size < len(p.Footers) {
+			size = len(p.Footers)
+		}
+	}
+
+	footers := make([]string, size)
+	for i := range footers {
+		if reducer, ok := p.columnReducers[i]; ok {
+			footers[i] = reducer(p.columnValues[i])
+			continue
+		}
+
+		if i < len(p.Footers) {
+			footers[i] = p.Footers[i]
+		}
+	}
+
+	return footers
+}
+
+// Flush finalizes a table that was built incrementally through `RenderRow`, it emits the
+// computed footer row, if any (see `SetColumnReducer` and `Footers`), and returns the total
+// amount of rows written to the table.
+//
+// Callers that only use `Render` do not need to call `Flush`, its footer is already included.
+func (p *Printer) Flush() int {
+	table := p.acquireTable()
+
+	if footers := p.computeFooters(p.columnCount); footers != nil {
+		table.SetFooterAlignment(int(p.FooterAlignment))
+		table.SetFooter(footers)
+		table.Render()
+	}
+
+	return table.NumLines()
+}
@@ -0,0 +1,85 @@
+package tableprinter
+
+import "sync"
+
+// Formatter transforms a single cell before it's written to the table. "colIndex" is the
+// zero-based column index, "header" is that column's header text and "value" is the already
+// stringified cell: `Print`/`PrintJSON` only ever hand `Render` a `[][]string`, so there is no
+// original typed value left by the time a `Formatter` runs, it only ever sees a string.
+//
+// See `Printer#Formatter`, `Printer#FirstColumnFormatter` and `Printer#HeaderFormatter`.
+// `RegisterFormatter`/`LookupFormatter` keep a name-to-`Formatter` registry around for callers
+// that resolve a formatter by name themselves (e.g. from a config file); this package's own
+// struct-tag parser is not part of this slice of the repository, so a `header:"...,format=name"`
+// tag is NOT resolved automatically yet, wiring that up is left for whoever owns that parser.
+type Formatter func(colIndex int, header string, value string) string
+
+var (
+	formattersMu sync.RWMutex
+	formatters   = map[string]Formatter{}
+)
+
+// RegisterFormatter makes "formatter" available to later `LookupFormatter` calls under the
+// given "name". It is not yet consulted automatically by any struct tag, see the `Formatter`
+// doc comment.
+func RegisterFormatter(name string, formatter Formatter) {
+	formattersMu.Lock()
+	defer formattersMu.Unlock()
+
+	formatters[name] = formatter
+}
+
+// LookupFormatter returns the `Formatter` previously registered under "name" through
+// `RegisterFormatter`, and false if none was registered.
+func LookupFormatter(name string) (Formatter, bool) {
+	formattersMu.RLock()
+	defer formattersMu.RUnlock()
+
+	formatter, ok := formatters[name]
+	return formatter, ok
+}
+
+// formatRow applies "p"'s `Formatter` (and `FirstColumnFormatter` for column 0) to a copy of
+// "row", "headers" is used for context.
+func (p *Printer) formatRow(headers []string, row []string) []string {
+	if p.Formatter == nil && p.FirstColumnFormatter == nil {
+		return row
+	}
+
+	formatted := make([]string, len(row))
+	for i, cell := range row {
+		header := ""
+		if i < len(headers) {
+			header = headers[i]
+		}
+
+		if i == 0 && p.FirstColumnFormatter != nil {
+			formatted[i] = p.FirstColumnFormatter(i, header, cell)
+			continue
+		}
+
+		if p.Formatter != nil {
+			formatted[i] = p.Formatter(i, header, cell)
+			continue
+		}
+
+		formatted[i] = cell
+	}
+
+	return formatted
+}
+
+// formatHeaders applies "p"'s `HeaderFormatter` to a copy of "headers", or returns "headers"
+// unchanged when no `HeaderFormatter` is set.
+func (p *Printer) formatHeaders(headers []string) []string {
+	if p.HeaderFormatter == nil {
+		return headers
+	}
+
+	formatted := make([]string, len(headers))
+	for i, header := range headers {
+		formatted[i] = p.HeaderFormatter(header)
+	}
+
+	return formatted
+}
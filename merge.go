@@ -0,0 +1,67 @@
+package tableprinter
+
+import (
+	"strings"
+
+	"github.com/kataras/tablewriter"
+)
+
+// applyAutoMergeCells forwards "p"'s `AutoMergeCells`/`MergeColumns` to "table" and, when
+// merging is enabled, collapses the row separator so grouped rows visually belong together.
+func (p *Printer) applyAutoMergeCells(table *tablewriter.Table) {
+	if !p.AutoMergeCells {
+		return
+	}
+
+	switch {
+	case p.mergeByHeaderUsed:
+		// MergeByHeader was used, even if none of its names matched (MergeColumns is empty),
+		// that must merge nothing rather than silently falling back to "merge every column".
+		table.SetAutoMergeCellsByColumnIndex(p.MergeColumns)
+	case len(p.MergeColumns) > 0:
+		table.SetAutoMergeCellsByColumnIndex(p.MergeColumns)
+	default:
+		table.SetAutoMergeCells(true)
+	}
+
+	table.SetRowLine(false)
+}
+
+// MergeByHeader enables `AutoMergeCells` and restricts it to the columns whose header matches
+// one of "names" (case-insensitive). It can be called before the headers are known, e.g. right
+// before `Print`:
+//
+//	p.MergeByHeader("NAMESPACE")
+//	p.Print(resources)
+//
+// the names are resolved against the parsed struct headers on the next `Print`/`PrintJSON`/
+// `PrintHeadList`/`Render` call. Names that don't match any known header are ignored.
+func (p *Printer) MergeByHeader(names ...string) {
+	p.AutoMergeCells = true
+	p.mergeByHeaderUsed = true
+	p.mergeColumnNames = names
+
+	if len(p.lastHeaders) > 0 {
+		p.resolveMergeColumnNames(p.lastHeaders)
+	}
+}
+
+// resolveMergeColumnNames turns a pending `MergeByHeader` name list into `MergeColumns` indices
+// once "headers" become known. It's a no-op when `MergeByHeader` wasn't used.
+func (p *Printer) resolveMergeColumnNames(headers []string) {
+	if len(p.mergeColumnNames) == 0 {
+		return
+	}
+
+	cols := make([]int, 0, len(p.mergeColumnNames))
+	for _, name := range p.mergeColumnNames {
+		for i, header := range headers {
+			if strings.EqualFold(header, name) {
+				cols = append(cols, i)
+				break
+			}
+		}
+	}
+
+	p.MergeColumns = cols
+}
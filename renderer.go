@@ -0,0 +1,246 @@
+package tableprinter
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+)
+
+// Renderer abstracts the final table serialization away from the parsing pipeline, so the same
+// headers, rows, alignments (see `Alignment`) and footer row produced by `Print`, `PrintJSON` and
+// `PrintHeadList` can be written out in different formats.
+//
+// The default `Printer` renders through github.com/kataras/tablewriter, see `MarkdownRenderer`
+// and `HTMLRenderer` for the alternatives shipped with this package, or `Printer#Renderer`
+// to plug in a custom one.
+type Renderer interface {
+	// RenderTable writes "headers", "rows", their "alignments" and an optional "footers" row
+	// (nil or empty when no footer should be shown) to "w".
+	// It returns the total amount of data rows written.
+	RenderTable(w io.Writer, headers []string, rows [][]string, alignments []int, footers []string) int
+}
+
+// Format is a `Printer.Renderer` identifier for the builtin renderers, see `Printer#SetFormat`.
+type Format int
+
+const (
+	// FormatTable renders a bordered terminal table through github.com/kataras/tablewriter, it's the default.
+	FormatTable Format = iota
+	// FormatMarkdown renders a GitHub-flavored markdown table, see `MarkdownRenderer`.
+	FormatMarkdown
+	// FormatCSV renders comma-separated values through encoding/csv, see `CSVRenderer`.
+	FormatCSV
+	// FormatHTML renders a minimal `<table>` element, see `HTMLRenderer`.
+	FormatHTML
+	// FormatJSON renders an array of header-to-value records, see `JSONRenderer`.
+	FormatJSON
+)
+
+// SetFormat sets "p"'s `Renderer` to one of the builtin implementations for the given `Format`.
+// FormatTable clears the renderer so the default tablewriter-based path is used instead.
+func (p *Printer) SetFormat(format Format) {
+	switch format {
+	case FormatMarkdown:
+		p.Renderer = MarkdownRenderer{}
+	case FormatCSV:
+		p.Renderer = CSVRenderer{}
+	case FormatHTML:
+		p.Renderer = HTMLRenderer{}
+	case FormatJSON:
+		p.Renderer = JSONRenderer{}
+	default:
+		p.Renderer = nil
+	}
+}
+
+// renderWith writes "headers"/"rows" through "r" instead of the tablewriter-based path,
+// it still honors `RowLengthTitle`, `AllowRowsOnly` and the footer reducers/`Footers` field.
+func (p *Printer) renderWith(r Renderer, headers []string, rows [][]string, numbersColsPosition []int) int {
+	if len(headers) == 0 && !p.AllowRowsOnly {
+		return 0
+	}
+
+	if len(headers) > 0 && p.RowLengthTitle != nil && p.RowLengthTitle(len(rows)) {
+		headers[0] = fmt.Sprintf("%s (%d) ", headers[0], len(rows))
+	}
+
+	p.columnValues = nil
+	p.collectColumnValues(rows)
+	footers := p.computeFooters(len(headers))
+
+	if len(headers) > 0 {
+		p.lastHeaders = headers
+		p.resolveMergeColumnNames(headers)
+	}
+
+	formattedHeaders := p.formatHeaders(headers)
+	formattedRows := make([][]string, len(rows))
+	for i, row := range rows {
+		formattedRows[i] = p.wrapRow(p.formatRow(headers, row))
+	}
+
+	alignments := p.calculateColumnAlignment(numbersColsPosition, len(headers))
+	return r.RenderTable(p.out, formattedHeaders, formattedRows, alignments, footers)
+}
+
+// MarkdownRenderer renders a GitHub-flavored markdown table: a header row, an alignment row
+// using `:---:`/`---:`/`:---`/`---` and one pipe-delimited row per data row.
+type MarkdownRenderer struct{}
+
+// RenderTable implements the `Renderer` interface.
+func (MarkdownRenderer) RenderTable(w io.Writer, headers []string, rows [][]string, alignments []int, footers []string) int {
+	if len(headers) == 0 {
+		return 0
+	}
+
+	fmt.Fprintf(w, "| %s |\n", strings.Join(headers, " | "))
+
+	separators := make([]string, len(headers))
+	for i := range separators {
+		align := AlignDefault
+		if i < len(alignments) {
+			align = Alignment(alignments[i])
+		}
+
+		switch align {
+		case AlignCenter:
+			separators[i] = ":---:"
+		case AlignRight:
+			separators[i] = "---:"
+		case AlignLeft:
+			separators[i] = ":---"
+		default:
+			separators[i] = "---"
+		}
+	}
+	fmt.Fprintf(w, "| %s |\n", strings.Join(separators, " | "))
+
+	for _, row := range rows {
+		fmt.Fprintf(w, "| %s |\n", strings.Join(row, " | "))
+	}
+
+	if len(footers) > 0 {
+		fmt.Fprintf(w, "| %s |\n", strings.Join(footers, " | "))
+	}
+
+	return len(rows)
+}
+
+// HTMLRenderer renders a minimal `<table>` element with `<thead>`, `<tbody>` and, when a
+// footer row is present, a `<tfoot>`. Headers, cells and footers are HTML-escaped by default
+// since this renderer's whole point is embedding tableprinter output into a web page, where
+// unescaped struct/JSON field values would be an XSS hole. Set `Unsafe` to skip escaping for
+// cells that are already-trusted HTML fragments.
+type HTMLRenderer struct {
+	// Unsafe disables HTML-escaping, only set it when every cell is known-trusted markup.
+	Unsafe bool
+}
+
+// RenderTable implements the `Renderer` interface.
+func (r HTMLRenderer) RenderTable(w io.Writer, headers []string, rows [][]string, alignments []int, footers []string) int {
+	escape := html.EscapeString
+	if r.Unsafe {
+		escape = func(s string) string { return s }
+	}
+
+	fmt.Fprint(w, "<table>\n")
+
+	if len(headers) > 0 {
+		fmt.Fprint(w, "  <thead>\n    <tr>")
+		for _, header := range headers {
+			fmt.Fprintf(w, "<th>%s</th>", escape(header))
+		}
+		fmt.Fprint(w, "</tr>\n  </thead>\n")
+	}
+
+	fmt.Fprint(w, "  <tbody>\n")
+	for _, row := range rows {
+		fmt.Fprint(w, "    <tr>")
+		for _, cell := range row {
+			fmt.Fprintf(w, "<td>%s</td>", escape(cell))
+		}
+		fmt.Fprint(w, "</tr>\n")
+	}
+	fmt.Fprint(w, "  </tbody>\n")
+
+	if len(footers) > 0 {
+		fmt.Fprint(w, "  <tfoot>\n    <tr>")
+		for _, cell := range footers {
+			fmt.Fprintf(w, "<th>%s</th>", escape(cell))
+		}
+		fmt.Fprint(w, "</tr>\n  </tfoot>\n")
+	}
+
+	fmt.Fprint(w, "</table>\n")
+	return len(rows)
+}
+
+// JSONRenderer renders the table as a JSON array of header-to-value records, one object per
+// row, which is convenient when the output is consumed by another program instead of a human.
+// The footer row, if any, is not part of the records array since it does not map to a record.
+type JSONRenderer struct{}
+
+// RenderTable implements the `Renderer` interface.
+func (JSONRenderer) RenderTable(w io.Writer, headers []string, rows [][]string, alignments []int, footers []string) int {
+	records := make([]map[string]string, 0, len(rows))
+	for _, row := range rows {
+		record := make(map[string]string, len(headers))
+		for i, header := range headers {
+			if i < len(row) {
+				record[header] = row[i]
+			}
+		}
+		records = append(records, record)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.Encode(records)
+
+	return len(rows)
+}
+
+// CSVRenderer renders comma-separated values through `encoding/csv`, it backs `Printer#SetFormat(FormatCSV)`.
+type CSVRenderer struct{}
+
+// RenderTable implements the `Renderer` interface.
+func (CSVRenderer) RenderTable(w io.Writer, headers []string, rows [][]string, alignments []int, footers []string) int {
+	cw := csv.NewWriter(w)
+
+	if len(headers) > 0 {
+		cw.Write(headers)
+	}
+	cw.WriteAll(rows)
+	if len(footers) > 0 {
+		cw.Write(footers)
+	}
+
+	cw.Flush()
+	return len(rows)
+}
+
+// PrintMarkdown outputs whatever "in" value passed as a GitHub-flavored markdown table to "w",
+// filters can be used to control what rows can be visible or hidden.
+//
+// Returns the total amount of rows written to the table.
+func PrintMarkdown(w io.Writer, in interface{}, filters ...interface{}) int {
+	p := New(w)
+	p.SetFormat(FormatMarkdown)
+	return p.Print(in, filters...)
+}
+
+// PrintCSVTable outputs whatever "in" value passed as comma-separated values to "w", filters can
+// be used to control what rows can be visible or hidden.
+//
+// It is named "...Table" rather than "PrintCSV" to avoid clashing with the package's
+// input-side `PrintCSV`, which reads a CSV/TSV document instead of writing one, see `CSVParser`.
+//
+// Returns the total amount of rows written to the table.
+func PrintCSVTable(w io.Writer, in interface{}, filters ...interface{}) int {
+	p := New(w)
+	p.SetFormat(FormatCSV)
+	return p.Print(in, filters...)
+}
@@ -40,6 +40,13 @@ type Printer struct {
 	HeaderLine      bool
 	HeaderAlignment Alignment
 
+	// Footers, when set, are rendered as the last row of the table, mirroring tablewriter's `SetFooter`.
+	// Columns registered through `SetColumnReducer` compute their own cell and take precedence
+	// over this field; there is no struct-tag-driven way to register a reducer yet, see `Reducer`'s
+	// doc comment.
+	Footers         []string
+	FooterAlignment Alignment
+
 	RowLine         bool
 	ColumnSeparator string
 	NewLine         string
@@ -51,7 +58,58 @@ type Printer struct {
 	RowLengthTitle func(int) bool
 	AllowRowsOnly  bool // if true then `Print/Render` will print the headers even if parsed rows where no found. Useful for putting rows to a table manually.
 
+	// Renderer, when not nil, replaces the default tablewriter-based output with another
+	// `Renderer` implementation, see `SetFormat` for the builtin ones. It only affects `Render`,
+	// `RenderRow`'s live-update streaming always goes through tablewriter.
+	Renderer Renderer
+
+	// Formatter, when not nil, transforms every cell before it's rendered, except the first
+	// column's when `FirstColumnFormatter` is set. There is no struct-tag-driven way to
+	// set this yet (see `RegisterFormatter`'s doc comment), it must be assigned programmatically.
+	Formatter Formatter
+	// FirstColumnFormatter, when not nil, transforms the first column's cells instead of `Formatter`.
+	FirstColumnFormatter Formatter
+	// HeaderFormatter, when not nil, transforms every header text before it's rendered,
+	// e.g. `strings.ToUpper`.
+	HeaderFormatter func(header string) string
+
+	// AutoMergeCells merges consecutive rows that share the same value in a column into a single
+	// visual cell, forwarded to the underlying tablewriter as `SetAutoMergeCells`/
+	// `SetAutoMergeCellsByColumnIndex`. See `MergeColumns` to restrict it to specific columns
+	// and `MergeByHeader` to pick those columns by name instead of index.
+	AutoMergeCells bool
+	// MergeColumns, when not empty, restricts `AutoMergeCells` to these zero-based column indices
+	// instead of merging every column.
+	MergeColumns []int
+
+	// SortBy, when not empty, sorts the rows produced by `SelectColumns` using that column's
+	// header or path and its typed value. It has no effect without `SelectColumns`.
+	SortBy string
+
+	// CSVOptions customizes `PrintCSV`'s delimiter, comment rune and header handling.
+	CSVOptions CSVOptions
+
+	// HeaderSeparatorRow, when true, emits a formatted divider row between the header and the
+	// data rows, useful when `HeaderLine`'s box-drawing lines don't render well once the output
+	// is copy-pasted somewhere else.
+	HeaderSeparatorRow bool
+	// MaxColumnWidth is the default max width (see `WidthFunc`) applied to every column's cells
+	// before they're rendered, 0 means unbounded. `MaxColumnWidths` overrides it per column.
+	MaxColumnWidth int
+	// MaxColumnWidths overrides `MaxColumnWidth` for specific zero-based column indices.
+	MaxColumnWidths map[int]int
+	// WidthFunc measures a cell's display width, defaults to `utf8.RuneCountInString` when nil.
+	WidthFunc WidthFunc
+
 	table *tablewriter.Table
+
+	columnReducers    map[int]Reducer
+	columnValues      map[int][]string
+	columnCount       int
+	lastHeaders       []string
+	mergeColumnNames  []string
+	mergeByHeaderUsed bool
+	columnSelectors   []ColumnSelector
 }
 
 // Default is the default Table Printer.
@@ -68,6 +126,8 @@ var Default = Printer{
 	HeaderLine:      true,
 	HeaderAlignment: AlignLeft,
 
+	FooterAlignment: AlignLeft,
+
 	RowLine:         false, /* it could be true as well */
 	ColumnSeparator: " ",
 	NewLine:         "\n",
@@ -102,6 +162,8 @@ func New(w io.Writer) *Printer {
 		HeaderLine:      Default.HeaderLine,
 		HeaderAlignment: Default.HeaderAlignment,
 
+		FooterAlignment: Default.FooterAlignment,
+
 		RowLine:         Default.RowLine,
 		ColumnSeparator: Default.ColumnSeparator,
 		NewLine:         Default.NewLine,
@@ -112,6 +174,10 @@ func New(w io.Writer) *Printer {
 
 		RowLengthTitle: Default.RowLengthTitle,
 		AllowRowsOnly:  Default.AllowRowsOnly,
+
+		HeaderSeparatorRow: Default.HeaderSeparatorRow,
+		MaxColumnWidth:     Default.MaxColumnWidth,
+		WidthFunc:          Default.WidthFunc,
 	}
 }
 
@@ -172,6 +238,10 @@ func Render(w io.Writer, headers []string, rows [][]string, numbersColsPosition
 //
 // Returns the total amount of rows written to the table.
 func (p *Printer) Render(headers []string, rows [][]string, numbersColsPosition []int, reset bool) int {
+	if p.Renderer != nil {
+		return p.renderWith(p.Renderer, headers, rows, numbersColsPosition)
+	}
+
 	table := p.acquireTable()
 
 	if reset {
@@ -179,23 +249,51 @@ func (p *Printer) Render(headers []string, rows [][]string, numbersColsPosition
 		// https://github.com/olekukonko/tablewriter/compare/master...kataras:master
 		table.ClearHeaders()
 		table.ClearRows()
+
+		p.columnValues = nil
 	}
 
+	p.collectColumnValues(rows)
+	footers := p.computeFooters(len(headers))
+	// resolved before `RowLengthTitle` appends the row count to the first header.
+	p.resolveMergeColumnNames(headers)
+
 	if len(headers) > 0 {
 		if p.RowLengthTitle != nil && p.RowLengthTitle(len(rows)) {
 			headers[0] = fmt.Sprintf("%s (%d) ", headers[0], len(rows))
 		}
 
-		table.SetHeader(headers)
+		table.SetHeader(p.formatHeaders(headers))
+		p.lastHeaders = headers
 	} else if !p.AllowRowsOnly {
 		return 0 // if not allow to print anything without headers, then exit.
 	}
 
-	table.AppendBulk(rows)
+	hasSeparator := false
+	if sep := p.headerSeparatorRow(headers); sep != nil {
+		table.Append(sep)
+		hasSeparator = true
+	}
+
+	for _, row := range rows {
+		table.Append(p.wrapRow(p.formatRow(headers, row)))
+	}
 	table.SetColumnAlignment(p.calculateColumnAlignment(numbersColsPosition, len(headers)))
+	p.applyAutoMergeCells(table)
+
+	if footers != nil {
+		table.SetFooterAlignment(int(p.FooterAlignment))
+		table.SetFooter(footers)
+	}
 
 	table.Render()
-	return table.NumLines()
+
+	lines := table.NumLines()
+	if hasSeparator {
+		// the divider row is a presentation detail, it must not count as a data row written.
+		lines--
+	}
+	return lines
 }
 
 // RenderRow prints a row based on the same alignment rules to the last `Print` or `Render`.
@@ -206,9 +304,11 @@ func (p *Printer) RenderRow(row []string, numbersColsPosition []int) int {
 	table := p.acquireTable()
 	table.SetColumnAlignment(p.calculateColumnAlignment(numbersColsPosition, len(row)))
 
+	p.collectColumnValues([][]string{row})
+
 	// RenderRowOnce added on kataras/tablewriter version, Changes from the original repository:
 	// https://github.com/olekukonko/tablewriter/compare/master...kataras:master
-	return table.RenderRowOnce(row)
+	return table.RenderRowOnce(p.formatRow(p.lastHeaders, row))
 }
 
 // Print outputs whatever "in" value passed as a table to the "w",
@@ -231,6 +331,10 @@ func Print(w io.Writer, v interface{}, filters ...interface{}) int {
 //
 // Returns the total amount of rows written to the table.
 func (p *Printer) Print(in interface{}, filters ...interface{}) int {
+	if len(p.columnSelectors) > 0 {
+		return p.printSelected(in, filters)
+	}
+
 	v := indirectValue(reflect.ValueOf(in))
 	f := MakeFilters(v, filters...)
 
@@ -0,0 +1,111 @@
+package tableprinter
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// WidthFunc measures the display width of a string, used by `MaxColumnWidths`/`MaxColumnWidth`
+// to wrap cells before they reach the table, and by `HeaderSeparatorRow` to size its divider.
+// It defaults to `utf8.RuneCountInString`, which undercounts double-width runes (CJK, emoji);
+// set `Printer#WidthFunc` to a wide-rune-aware measurer to fix that.
+type WidthFunc func(string) int
+
+func defaultWidthFunc(s string) int {
+	return utf8.RuneCountInString(s)
+}
+
+// widthFunc returns `p.WidthFunc`, or the default rune-counting measurer when unset.
+func (p *Printer) widthFunc() WidthFunc {
+	if p.WidthFunc != nil {
+		return p.WidthFunc
+	}
+
+	return defaultWidthFunc
+}
+
+// maxWidthFor returns the configured max width for column "col", falling back to
+// `MaxColumnWidth`. 0 means unbounded.
+func (p *Printer) maxWidthFor(col int) int {
+	if w, ok := p.MaxColumnWidths[col]; ok {
+		return w
+	}
+
+	return p.MaxColumnWidth
+}
+
+// wrapCell breaks "value" into lines of at most "max" width (as measured by "widthFunc"),
+// joined back with "\n" since that's how tablewriter renders multi-line cells. It returns
+// "value" unchanged when "max" is 0 (unbounded) or already short enough.
+func wrapCell(value string, max int, widthFunc WidthFunc) string {
+	if max <= 0 || widthFunc(value) <= max {
+		return value
+	}
+
+	var lines []string
+	var line []rune
+	width := 0
+
+	for _, r := range value {
+		rw := widthFunc(string(r))
+		if rw <= 0 {
+			rw = 1
+		}
+
+		if width+rw > max && len(line) > 0 {
+			lines = append(lines, string(line))
+			line = line[:0]
+			width = 0
+		}
+
+		line = append(line, r)
+		width += rw
+	}
+
+	if len(line) > 0 {
+		lines = append(lines, string(line))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// wrapRow applies `maxWidthFor`/`WidthFunc` to every cell of "row" and returns a new slice, it
+// returns "row" itself when neither `MaxColumnWidth` nor `MaxColumnWidths` is set.
+func (p *Printer) wrapRow(row []string) []string {
+	if p.MaxColumnWidth <= 0 && len(p.MaxColumnWidths) == 0 {
+		return row
+	}
+
+	wf := p.widthFunc()
+	wrapped := make([]string, len(row))
+	for i, cell := range row {
+		wrapped[i] = wrapCell(cell, p.maxWidthFor(i), wf)
+	}
+
+	return wrapped
+}
+
+// headerSeparatorRow builds the divider row emitted between the header and the data rows when
+// `HeaderSeparatorRow` is enabled: one cell per header, each repeating "-" to match that
+// column's formatted header width (as measured by `WidthFunc`). It returns nil when
+// `HeaderSeparatorRow` is false or there are no headers.
+func (p *Printer) headerSeparatorRow(headers []string) []string {
+	if !p.HeaderSeparatorRow || len(headers) == 0 {
+		return nil
+	}
+
+	wf := p.widthFunc()
+	formatted := p.formatHeaders(headers)
+
+	row := make([]string, len(formatted))
+	for i, header := range formatted {
+		width := wf(header)
+		if width <= 0 {
+			width = 1
+		}
+
+		row[i] = strings.Repeat("-", width)
+	}
+
+	return row
+}